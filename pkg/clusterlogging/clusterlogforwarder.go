@@ -3,16 +3,40 @@ package clusterlogging
 import (
 	"context"
 	"fmt"
+	"time"
 
-	"github.com/golang/glog"
 	"github.com/openshift-kni/eco-goinfra/pkg/clients"
 	"github.com/openshift-kni/eco-goinfra/pkg/msg"
 	clov1 "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
+// reservedInputNames are the built-in input types that pipelines may reference without a
+// matching entry in Spec.Inputs.
+var reservedInputNames = map[string]bool{
+	"application":    true,
+	"infrastructure": true,
+	"audit":          true,
+}
+
+// preserveOnDeleteAnnotation, when set to "true" on the clusterlogforwarder, instructs Delete to
+// orphan the downstream collector resources instead of cascading their deletion.
+const preserveOnDeleteAnnotation = "logging.openshift.io/preserve-on-delete"
+
+// fieldManager identifies eco-goinfra to the API server when it server-side applies a
+// clusterlogforwarder, so other controllers' ownership of disjoint fields is preserved.
+const fieldManager = "eco-goinfra/clusterlogging"
+
+// conditionDegraded is the condition type reported against an individual input, output, or
+// pipeline when it is not functioning correctly, independent of the top-level Ready condition.
+const conditionDegraded clov1.ConditionType = "Degraded"
+
 // ClusterLogForwarderBuilder provides a struct for clusterlogforwarder object from the
 // cluster and a clusterlogforwarder definition.
 type ClusterLogForwarderBuilder struct {
@@ -28,7 +52,7 @@ type ClusterLogForwarderBuilder struct {
 
 // PullClusterLogForwarder retrieves an existing clusterlogforwarder object from the cluster.
 func PullClusterLogForwarder(apiClient *clients.Settings, name, namespace string) (*clov1.ClusterLogForwarder, error) {
-	glog.V(100).Infof("Pulling existing clusterlogforwarder %s in namespace %s", name, namespace)
+	klog.V(100).InfoS("Pulling existing clusterlogforwarder", "name", name, "namespace", namespace)
 
 	builder := ClusterLogForwarderBuilder{
 		apiClient: apiClient,
@@ -41,13 +65,13 @@ func PullClusterLogForwarder(apiClient *clients.Settings, name, namespace string
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the clusterlogforwarder is empty")
+		klog.V(100).Info("The name of the clusterlogforwarder is empty")
 
 		builder.errorMsg = "clusterlogforwarder 'name' cannot be empty"
 	}
 
 	if namespace == "" {
-		glog.V(100).Infof("The namespace of the clusterlogforwarder is empty")
+		klog.V(100).Info("The namespace of the clusterlogforwarder is empty")
 
 		builder.errorMsg = "clusterlogforwarder 'namespace' cannot be empty"
 	}
@@ -59,14 +83,322 @@ func PullClusterLogForwarder(apiClient *clients.Settings, name, namespace string
 	return builder.Object, nil
 }
 
+// WithInput adds an input with the given name to the clusterlogforwarder definition.
+func (builder *ClusterLogForwarderBuilder) WithInput(name string, input clov1.InputSpec) *ClusterLogForwarderBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).InfoS("Adding input to clusterlogforwarder",
+		"input", name, "clusterlogforwarder", klog.KObj(builder.Definition))
+
+	if name == "" {
+		klog.V(100).Info("The name of the clusterlogforwarder input is empty")
+
+		builder.errorMsg = "clusterlogforwarder input 'name' cannot be empty"
+
+		return builder
+	}
+
+	if reservedInputNames[name] {
+		builder.errorMsg = fmt.Sprintf("clusterlogforwarder input name %q is reserved", name)
+
+		return builder
+	}
+
+	for _, existing := range builder.Definition.Spec.Inputs {
+		if existing.Name == name {
+			builder.errorMsg = fmt.Sprintf("clusterlogforwarder already has an input named %q", name)
+
+			return builder
+		}
+	}
+
+	input.Name = name
+	builder.Definition.Spec.Inputs = append(builder.Definition.Spec.Inputs, input)
+
+	return builder
+}
+
+// WithOutput adds an output with the given name to the clusterlogforwarder definition. The output
+// may target Elasticsearch, Loki, Kafka, Cloudwatch, Splunk, Syslog, or HTTP, as set on out. A
+// secret referenced by out.Secret is always resolved in the clusterlogforwarder's own namespace by
+// the operator; WithOutput does not look it up against the cluster, so the secret may be created
+// before or after the output is staged here.
+func (builder *ClusterLogForwarderBuilder) WithOutput(name string, out clov1.OutputSpec) *ClusterLogForwarderBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).InfoS("Adding output to clusterlogforwarder",
+		"output", name, "clusterlogforwarder", klog.KObj(builder.Definition))
+
+	if name == "" {
+		klog.V(100).Info("The name of the clusterlogforwarder output is empty")
+
+		builder.errorMsg = "clusterlogforwarder output 'name' cannot be empty"
+
+		return builder
+	}
+
+	for _, existing := range builder.Definition.Spec.Outputs {
+		if existing.Name == name {
+			builder.errorMsg = fmt.Sprintf("clusterlogforwarder already has an output named %q", name)
+
+			return builder
+		}
+	}
+
+	out.Name = name
+	builder.Definition.Spec.Outputs = append(builder.Definition.Spec.Outputs, out)
+
+	return builder
+}
+
+// WithPipeline adds a pipeline to the clusterlogforwarder definition, validating that every input,
+// output, and filter it references already exists on the builder. Input references to the reserved
+// inputs (application, infrastructure, audit) are accepted without a matching input.
+func (builder *ClusterLogForwarderBuilder) WithPipeline(pipeline clov1.PipelineSpec) *ClusterLogForwarderBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).InfoS("Adding pipeline to clusterlogforwarder",
+		"pipeline", pipeline.Name, "clusterlogforwarder", klog.KObj(builder.Definition))
+
+	if pipeline.Name == "" {
+		builder.errorMsg = "clusterlogforwarder pipeline 'name' cannot be empty"
+
+		return builder
+	}
+
+	for _, existing := range builder.Definition.Spec.Pipelines {
+		if existing.Name == pipeline.Name {
+			builder.errorMsg = fmt.Sprintf("clusterlogforwarder already has a pipeline named %q", pipeline.Name)
+
+			return builder
+		}
+	}
+
+	for _, ref := range pipeline.InputRefs {
+		if !reservedInputNames[ref] && !builder.hasInput(ref) {
+			builder.errorMsg = fmt.Sprintf("pipeline %q references undefined input %q", pipeline.Name, ref)
+
+			return builder
+		}
+	}
+
+	for _, ref := range pipeline.OutputRefs {
+		if !builder.hasOutput(ref) {
+			builder.errorMsg = fmt.Sprintf("pipeline %q references undefined output %q", pipeline.Name, ref)
+
+			return builder
+		}
+	}
+
+	for _, ref := range pipeline.FilterRefs {
+		if !builder.hasFilter(ref) {
+			builder.errorMsg = fmt.Sprintf("pipeline %q references undefined filter %q", pipeline.Name, ref)
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.Pipelines = append(builder.Definition.Spec.Pipelines, pipeline)
+
+	return builder
+}
+
+// WithFilter adds a filter with the given name to the clusterlogforwarder definition.
+func (builder *ClusterLogForwarderBuilder) WithFilter(filter clov1.FilterSpec) *ClusterLogForwarderBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).InfoS("Adding filter to clusterlogforwarder",
+		"filter", filter.Name, "clusterlogforwarder", klog.KObj(builder.Definition))
+
+	if filter.Name == "" {
+		builder.errorMsg = "clusterlogforwarder filter 'name' cannot be empty"
+
+		return builder
+	}
+
+	for _, existing := range builder.Definition.Spec.Filters {
+		if existing.Name == filter.Name {
+			builder.errorMsg = fmt.Sprintf("clusterlogforwarder already has a filter named %q", filter.Name)
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.Filters = append(builder.Definition.Spec.Filters, filter)
+
+	return builder
+}
+
+// WithServiceAccount sets the service account used by the clusterlogforwarder's collector.
+func (builder *ClusterLogForwarderBuilder) WithServiceAccount(name string) *ClusterLogForwarderBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).InfoS("Setting service account on clusterlogforwarder",
+		"serviceAccount", name, "clusterlogforwarder", klog.KObj(builder.Definition))
+
+	if name == "" {
+		builder.errorMsg = "clusterlogforwarder serviceAccount 'name' cannot be empty"
+
+		return builder
+	}
+
+	builder.Definition.Spec.ServiceAccountName = name
+
+	return builder
+}
+
+// WithPreserveOnDelete marks the clusterlogforwarder so that Delete orphans the collector
+// DaemonSets and Secrets it owns instead of cascading their deletion, letting log flow continue
+// after the CR itself is removed. The annotation only changes Delete's propagation policy; use
+// AdoptResource to re-attach the preserved resources once a replacement clusterlogforwarder exists.
+func (builder *ClusterLogForwarderBuilder) WithPreserveOnDelete(preserve bool) *ClusterLogForwarderBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).InfoS("Setting preserve-on-delete on clusterlogforwarder",
+		"preserve", preserve, "clusterlogforwarder", klog.KObj(builder.Definition))
+
+	if builder.Definition.Annotations == nil {
+		builder.Definition.Annotations = make(map[string]string)
+	}
+
+	if preserve {
+		builder.Definition.Annotations[preserveOnDeleteAnnotation] = "true"
+	} else {
+		delete(builder.Definition.Annotations, preserveOnDeleteAnnotation)
+	}
+
+	return builder
+}
+
+// AddFinalizer appends the given finalizer to the clusterlogforwarder definition, if it is not
+// already present.
+func (builder *ClusterLogForwarderBuilder) AddFinalizer(name string) *ClusterLogForwarderBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).InfoS("Adding finalizer to clusterlogforwarder",
+		"finalizer", name, "clusterlogforwarder", klog.KObj(builder.Definition))
+
+	if name == "" {
+		builder.errorMsg = "clusterlogforwarder finalizer 'name' cannot be empty"
+
+		return builder
+	}
+
+	for _, finalizer := range builder.Definition.Finalizers {
+		if finalizer == name {
+			return builder
+		}
+	}
+
+	builder.Definition.Finalizers = append(builder.Definition.Finalizers, name)
+
+	return builder
+}
+
+// RemoveFinalizer removes the given finalizer from the clusterlogforwarder definition, if present.
+func (builder *ClusterLogForwarderBuilder) RemoveFinalizer(name string) *ClusterLogForwarderBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).InfoS("Removing finalizer from clusterlogforwarder",
+		"finalizer", name, "clusterlogforwarder", klog.KObj(builder.Definition))
+
+	for i, finalizer := range builder.Definition.Finalizers {
+		if finalizer == name {
+			builder.Definition.Finalizers = append(builder.Definition.Finalizers[:i], builder.Definition.Finalizers[i+1:]...)
+
+			break
+		}
+	}
+
+	return builder
+}
+
+// preserveOnDelete reports whether the clusterlogforwarder carries preserveOnDeleteAnnotation.
+func (builder *ClusterLogForwarderBuilder) preserveOnDelete() bool {
+	return builder.Definition.Annotations[preserveOnDeleteAnnotation] == "true"
+}
+
+// AdoptResource re-establishes ownership of a downstream resource (e.g. a collector DaemonSet or
+// Secret) that a prior preserve-on-delete Delete orphaned, by setting an owner reference back to
+// this clusterlogforwarder and persisting the change. This is the re-adoption step WithPreserveOnDelete
+// relies on: the annotation only tells Delete to orphan rather than cascade, so callers that
+// recreate the clusterlogforwarder must call AdoptResource against each preserved resource
+// themselves for the operator to resume managing it.
+func (builder *ClusterLogForwarderBuilder) AdoptResource(ctx context.Context, obj goclient.Object) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	klog.V(100).InfoS("Adopting orphaned resource into clusterlogforwarder",
+		"resource", klog.KObj(obj), "clusterlogforwarder", klog.KObj(builder.Definition))
+
+	if err := controllerutil.SetOwnerReference(builder.Definition, obj, builder.apiClient.Scheme()); err != nil {
+		return fmt.Errorf("failed to set owner reference while adopting resource %s: %w", obj.GetName(), err)
+	}
+
+	if err := builder.apiClient.Update(ctx, obj); err != nil {
+		return fmt.Errorf("failed to adopt resource %s: %w", obj.GetName(), err)
+	}
+
+	return nil
+}
+
+// hasInput returns true if an input with the given name was already added to the builder.
+func (builder *ClusterLogForwarderBuilder) hasInput(name string) bool {
+	for _, input := range builder.Definition.Spec.Inputs {
+		if input.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasOutput returns true if an output with the given name was already added to the builder.
+func (builder *ClusterLogForwarderBuilder) hasOutput(name string) bool {
+	for _, output := range builder.Definition.Spec.Outputs {
+		if output.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasFilter returns true if a filter with the given name was already added to the builder.
+func (builder *ClusterLogForwarderBuilder) hasFilter(name string) bool {
+	for _, filter := range builder.Definition.Spec.Filters {
+		if filter.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Get returns clusterlogforwarder object if found.
 func (builder *ClusterLogForwarderBuilder) Get() (*clov1.ClusterLogForwarder, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
 
-	glog.V(100).Infof("Getting clusterlogforwarder %s in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	klog.V(100).InfoS("Getting clusterlogforwarder", "clusterlogforwarder", klog.KObj(builder.Definition))
 
 	clusterLogForwarder := &clov1.ClusterLogForwarder{}
 	err := builder.apiClient.Get(context.Background(), goclient.ObjectKey{
@@ -87,8 +419,7 @@ func (builder *ClusterLogForwarderBuilder) Create() (*ClusterLogForwarderBuilder
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the clusterlogforwarder %s in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	klog.V(100).InfoS("Creating the clusterlogforwarder", "clusterlogforwarder", klog.KObj(builder.Definition))
 
 	var err error
 	if !builder.Exists() {
@@ -101,20 +432,32 @@ func (builder *ClusterLogForwarderBuilder) Create() (*ClusterLogForwarderBuilder
 	return builder, err
 }
 
-// Delete removes clusterlogforwarder from a cluster.
+// Delete removes clusterlogforwarder from a cluster. If the clusterlogforwarder carries the
+// preserveOnDeleteAnnotation, set via WithPreserveOnDelete, the CR is deleted with an orphan
+// propagation policy so the collector DaemonSets and Secrets it owns are left running, orphaned
+// (ownerReferences pointing at a CR that no longer exists). Use AdoptResource against each of them
+// once a replacement clusterlogforwarder exists to have the operator resume managing them.
 func (builder *ClusterLogForwarderBuilder) Delete() error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the clusterlogforwarder %s in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	klog.V(100).InfoS("Deleting the clusterlogforwarder", "clusterlogforwarder", klog.KObj(builder.Definition))
 
 	if !builder.Exists() {
 		return fmt.Errorf("clusterlogforwarder cannot be deleted because it does not exist")
 	}
 
-	err := builder.apiClient.Delete(context.Background(), builder.Definition)
+	var deleteOptions []goclient.DeleteOption
+
+	if builder.preserveOnDelete() {
+		klog.V(100).InfoS("Preserving downstream collector resources on delete",
+			"clusterlogforwarder", klog.KObj(builder.Definition))
+
+		deleteOptions = append(deleteOptions, goclient.PropagationPolicy(metaV1.DeletePropagationOrphan))
+	}
+
+	err := builder.apiClient.Delete(context.Background(), builder.Definition, deleteOptions...)
 
 	if err != nil {
 		return fmt.Errorf("can not delete clusterlogforwarder: %w", err)
@@ -131,8 +474,7 @@ func (builder *ClusterLogForwarderBuilder) Exists() bool {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if clusterlogforwarder %s exists in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	klog.V(100).InfoS("Checking if clusterlogforwarder exists", "clusterlogforwarder", klog.KObj(builder.Definition))
 
 	var err error
 	builder.Object, err = builder.Get()
@@ -140,42 +482,185 @@ func (builder *ClusterLogForwarderBuilder) Exists() bool {
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
-// Update renovates the existing clusterlogforwarder object with clusterlogforwarder definition in builder.
-func (builder *ClusterLogForwarderBuilder) Update(force bool) (*ClusterLogForwarderBuilder, error) {
+// WaitUntilReady polls the clusterlogforwarder until its top-level Ready condition is true and
+// none of its inputs, outputs, or pipelines report Degraded, or until timeout elapses. A forwarder
+// that is Ready but has a Degraded output is not considered ready. It returns an error containing
+// the failing component or condition's message on timeout.
+func (builder *ClusterLogForwarderBuilder) WaitUntilReady(timeout time.Duration) (*ClusterLogForwarderBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(100).Info("Updating clusterlogforwarder %s in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	klog.V(100).InfoS("Waiting for clusterlogforwarder to become ready",
+		"clusterlogforwarder", klog.KObj(builder.Definition), "timeout", timeout)
+
+	var lastMessage string
+
+	err := wait.PollUntilContextTimeout(
+		context.Background(), 3*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			updated, err := builder.Get()
+			if err != nil {
+				return false, nil
+			}
+
+			builder.Object = updated
+
+			klog.FromContext(ctx).V(100).Info("Polled clusterlogforwarder conditions",
+				"clusterlogforwarder", klog.KObj(updated))
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+			if component, message, degraded := firstDegradedComponent(updated.Status); degraded {
+				lastMessage = fmt.Sprintf("%s is degraded: %s", component, message)
+
+				return false, nil
+			}
+
+			for i := range updated.Status.Conditions {
+				condition := &updated.Status.Conditions[i]
+
+				if condition.Type == clov1.ConditionReady {
+					lastMessage = condition.Message
+
+					return condition.Status == corev1.ConditionTrue, nil
+				}
+			}
+
+			return false, nil
+		})
 
 	if err != nil {
-		if force {
-			glog.V(100).Infof("Failed to update the clusterlogforwarder object %s in namespace $s. "+
-				"Note: Force flag set, executed delete/create methods instead",
-				builder.Definition.Name, builder.Definition.Namespace)
+		if lastMessage != "" {
+			return builder, fmt.Errorf("clusterlogforwarder %s in namespace %s did not become ready: %s",
+				builder.Definition.Name, builder.Definition.Namespace, lastMessage)
+		}
+
+		return builder, fmt.Errorf("clusterlogforwarder %s in namespace %s did not report readiness before timeout",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return builder, nil
+}
+
+// firstDegradedComponent returns a human-readable identifier and message for the first input,
+// output, or pipeline reporting a Degraded=true condition in status, if any.
+func firstDegradedComponent(status clov1.ClusterLogForwarderStatus) (component, message string, degraded bool) {
+	groups := []struct {
+		kind       string
+		conditions clov1.NamedConditions
+	}{
+		{"input", status.Inputs},
+		{"output", status.Outputs},
+		{"pipeline", status.Pipelines},
+	}
 
-			err := builder.Delete()
+	for _, group := range groups {
+		for name, conditions := range group.conditions {
+			for _, condition := range conditions {
+				if condition.Type == conditionDegraded && condition.Status == corev1.ConditionTrue {
+					return fmt.Sprintf("%s %q", group.kind, name), condition.Message, true
+				}
+			}
+		}
+	}
+
+	return "", "", false
+}
+
+// WaitUntilCondition polls the clusterlogforwarder until its top-level status reports the given
+// condition type with the given status, or until timeout elapses. It does not inspect the
+// per-input/output/pipeline conditions; use WaitUntilReady when those also need to be healthy. On
+// timeout, the returned error contains the message of the last observed condition of that type, if
+// any was reported.
+func (builder *ClusterLogForwarderBuilder) WaitUntilCondition(
+	condType clov1.ConditionType, status corev1.ConditionStatus, timeout time.Duration) (
+	*ClusterLogForwarderBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	klog.V(100).InfoS("Waiting for clusterlogforwarder condition",
+		"clusterlogforwarder", klog.KObj(builder.Definition), "conditionType", condType, "status", status, "timeout", timeout)
+
+	var lastCondition *clov1.Condition
 
+	err := wait.PollUntilContextTimeout(
+		context.Background(), 3*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			updated, err := builder.Get()
 			if err != nil {
-				glog.V(100).Infof(
-					"Failed to update the clusterlogforwarder object %s in namespace $s."+
-						"due to error in delete function", builder.Definition.Name, builder.Definition.Namespace)
+				return false, nil
+			}
+
+			builder.Object = updated
+
+			klog.FromContext(ctx).V(100).Info("Polled clusterlogforwarder conditions",
+				"clusterlogforwarder", klog.KObj(updated))
 
-				return nil, err
+			for i := range updated.Status.Conditions {
+				condition := &updated.Status.Conditions[i]
+
+				if condition.Type == condType {
+					lastCondition = condition
+
+					if condition.Status == status {
+						return true, nil
+					}
+				}
 			}
 
-			return builder.Create()
+			return false, nil
+		})
+
+	if err != nil {
+		if lastCondition != nil {
+			return builder, fmt.Errorf("clusterlogforwarder %s in namespace %s did not reach condition %s=%s: %s",
+				builder.Definition.Name, builder.Definition.Namespace, condType, status, lastCondition.Message)
 		}
+
+		return builder, fmt.Errorf("clusterlogforwarder %s in namespace %s did not report condition %s before timeout",
+			builder.Definition.Name, builder.Definition.Namespace, condType)
+	}
+
+	return builder, nil
+}
+
+// Update renovates the existing clusterlogforwarder object with clusterlogforwarder definition in
+// builder, using server-side apply so that other controllers co-owning disjoint fields (e.g. a
+// GitOps tool owning spec.outputs) are left untouched. When force is true, eco-goinfra takes
+// ownership of any field in conflict; otherwise a conflict is returned as a typed error the caller
+// can inspect with k8serrors.IsConflict.
+func (builder *ClusterLogForwarderBuilder) Update(force bool) (*ClusterLogForwarderBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
 	}
 
-	if err == nil {
-		builder.Object = builder.Definition
+	klog.V(100).InfoS("Server-side applying clusterlogforwarder",
+		"clusterlogforwarder", klog.KObj(builder.Definition), "force", force)
+
+	builder.Definition.TypeMeta = metaV1.TypeMeta{
+		APIVersion: clov1.GroupVersion.String(),
+		Kind:       "ClusterLogForwarder",
 	}
 
-	return builder, err
+	patchOptions := []goclient.PatchOption{goclient.FieldOwner(fieldManager)}
+
+	if force {
+		patchOptions = append(patchOptions, goclient.ForceOwnership)
+	}
+
+	err := builder.apiClient.Patch(context.TODO(), builder.Definition, goclient.Apply, patchOptions...)
+
+	if err != nil {
+		if k8serrors.IsConflict(err) {
+			// Returned unwrapped so callers can rely on k8serrors.IsConflict(err) regardless of
+			// whether the vendored apimachinery version unwraps via errors.As.
+			return builder, err
+		}
+
+		return builder, fmt.Errorf("failed to apply clusterlogforwarder: %w", err)
+	}
+
+	builder.Object = builder.Definition
+
+	return builder, nil
 }
 
 // validate will check that the builder and builder definition are properly initialized before
@@ -184,22 +669,28 @@ func (builder *ClusterLogForwarderBuilder) validate() (bool, error) {
 	resourceCRD := "ClusterLogForwarder"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		klog.V(100).InfoS("The builder is uninitialized", "resource", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		klog.V(100).InfoS("The resource is undefined", "resource", resourceCRD)
 
 		return false, fmt.Errorf(msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		klog.V(100).InfoS("The builder apiclient is nil", "resource", resourceCRD)
 
 		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
+	if builder.errorMsg != "" {
+		klog.V(100).InfoS("The builder has an error message", "resource", resourceCRD, "errorMsg", builder.errorMsg)
+
+		return false, fmt.Errorf(builder.errorMsg)
+	}
+
 	return true, nil
 }
\ No newline at end of file
@@ -0,0 +1,284 @@
+package clusterlogging
+
+import (
+	"testing"
+
+	"github.com/openshift-kni/eco-goinfra/pkg/clients"
+	clov1 "github.com/openshift/cluster-logging-operator/apis/logging/v1"
+	"github.com/stretchr/testify/assert"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultCLFName      = "test-clf"
+	defaultCLFNamespace = "test-namespace"
+)
+
+func buildDummyClusterLogForwarder() *clov1.ClusterLogForwarder {
+	return &clov1.ClusterLogForwarder{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:      defaultCLFName,
+			Namespace: defaultCLFNamespace,
+		},
+	}
+}
+
+func buildTestBuilderWithDefinition(definition *clov1.ClusterLogForwarder, objects ...runtime.Object) *ClusterLogForwarderBuilder {
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: objects,
+		SchemeAttachers: []clients.SchemeAttacher{
+			clov1.AddToScheme,
+		},
+	})
+
+	return &ClusterLogForwarderBuilder{
+		apiClient:  testSettings,
+		Definition: definition,
+	}
+}
+
+func TestWithInput(t *testing.T) {
+	testCases := []struct {
+		name          string
+		inputName     string
+		existingNames []string
+		expectedError string
+	}{
+		{
+			name:      "valid input",
+			inputName: "my-input",
+		},
+		{
+			name:          "empty name",
+			inputName:     "",
+			expectedError: "clusterlogforwarder input 'name' cannot be empty",
+		},
+		{
+			name:          "reserved name",
+			inputName:     "application",
+			expectedError: `clusterlogforwarder input name "application" is reserved`,
+		},
+		{
+			name:          "duplicate name",
+			inputName:     "my-input",
+			existingNames: []string{"my-input"},
+			expectedError: `clusterlogforwarder already has an input named "my-input"`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			builder := buildTestBuilderWithDefinition(buildDummyClusterLogForwarder())
+
+			for _, existing := range testCase.existingNames {
+				builder.Definition.Spec.Inputs = append(builder.Definition.Spec.Inputs, clov1.InputSpec{Name: existing})
+			}
+
+			builder.WithInput(testCase.inputName, clov1.InputSpec{})
+
+			if testCase.expectedError == "" {
+				assert.Empty(t, builder.errorMsg)
+				assert.True(t, builder.hasInput(testCase.inputName))
+			} else {
+				assert.Equal(t, testCase.expectedError, builder.errorMsg)
+			}
+		})
+	}
+}
+
+func TestWithOutput(t *testing.T) {
+	testCases := []struct {
+		name          string
+		outputName    string
+		existingNames []string
+		expectedError string
+	}{
+		{
+			name:       "valid output",
+			outputName: "my-output",
+		},
+		{
+			name:          "empty name",
+			outputName:    "",
+			expectedError: "clusterlogforwarder output 'name' cannot be empty",
+		},
+		{
+			name:          "duplicate name",
+			outputName:    "my-output",
+			existingNames: []string{"my-output"},
+			expectedError: `clusterlogforwarder already has an output named "my-output"`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			builder := buildTestBuilderWithDefinition(buildDummyClusterLogForwarder())
+
+			for _, existing := range testCase.existingNames {
+				builder.Definition.Spec.Outputs = append(builder.Definition.Spec.Outputs, clov1.OutputSpec{Name: existing})
+			}
+
+			builder.WithOutput(testCase.outputName, clov1.OutputSpec{})
+
+			if testCase.expectedError == "" {
+				assert.Empty(t, builder.errorMsg)
+				assert.True(t, builder.hasOutput(testCase.outputName))
+			} else {
+				assert.Equal(t, testCase.expectedError, builder.errorMsg)
+			}
+		})
+	}
+}
+
+// TestWithOutputDoesNotRequireSecretToExist guards against reintroducing a live cluster lookup in
+// WithOutput: the secret an output references does not need to exist yet for the output to be
+// staged on the builder.
+func TestWithOutputDoesNotRequireSecretToExist(t *testing.T) {
+	builder := buildTestBuilderWithDefinition(buildDummyClusterLogForwarder())
+
+	builder.WithOutput("my-output", clov1.OutputSpec{
+		Secret: &clov1.OutputSecretSpec{Name: "not-yet-created"},
+	})
+
+	assert.Empty(t, builder.errorMsg)
+	assert.True(t, builder.hasOutput("my-output"))
+}
+
+func TestWithFilter(t *testing.T) {
+	testCases := []struct {
+		name          string
+		filterName    string
+		existingNames []string
+		expectedError string
+	}{
+		{
+			name:       "valid filter",
+			filterName: "my-filter",
+		},
+		{
+			name:          "empty name",
+			filterName:    "",
+			expectedError: "clusterlogforwarder filter 'name' cannot be empty",
+		},
+		{
+			name:          "duplicate name",
+			filterName:    "my-filter",
+			existingNames: []string{"my-filter"},
+			expectedError: `clusterlogforwarder already has a filter named "my-filter"`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			builder := buildTestBuilderWithDefinition(buildDummyClusterLogForwarder())
+
+			for _, existing := range testCase.existingNames {
+				builder.Definition.Spec.Filters = append(builder.Definition.Spec.Filters, clov1.FilterSpec{Name: existing})
+			}
+
+			builder.WithFilter(clov1.FilterSpec{Name: testCase.filterName})
+
+			if testCase.expectedError == "" {
+				assert.Empty(t, builder.errorMsg)
+				assert.True(t, builder.hasFilter(testCase.filterName))
+			} else {
+				assert.Equal(t, testCase.expectedError, builder.errorMsg)
+			}
+		})
+	}
+}
+
+func TestWithPipelineReferenceValidation(t *testing.T) {
+	testCases := []struct {
+		name          string
+		pipeline      clov1.PipelineSpec
+		setup         func(*ClusterLogForwarderBuilder)
+		expectedError string
+	}{
+		{
+			name:          "undefined input",
+			pipeline:      clov1.PipelineSpec{Name: "pipeline1", InputRefs: []string{"missing-input"}},
+			expectedError: `pipeline "pipeline1" references undefined input "missing-input"`,
+		},
+		{
+			name: "undefined output",
+			pipeline: clov1.PipelineSpec{
+				Name:       "pipeline1",
+				InputRefs:  []string{"application"},
+				OutputRefs: []string{"missing-output"},
+			},
+			expectedError: `pipeline "pipeline1" references undefined output "missing-output"`,
+		},
+		{
+			name: "undefined filter",
+			pipeline: clov1.PipelineSpec{
+				Name:       "pipeline1",
+				InputRefs:  []string{"application"},
+				OutputRefs: []string{"out1"},
+				FilterRefs: []string{"missing-filter"},
+			},
+			setup: func(builder *ClusterLogForwarderBuilder) {
+				builder.Definition.Spec.Outputs = append(builder.Definition.Spec.Outputs, clov1.OutputSpec{Name: "out1"})
+			},
+			expectedError: `pipeline "pipeline1" references undefined filter "missing-filter"`,
+		},
+		{
+			name: "valid pipeline using a reserved input",
+			pipeline: clov1.PipelineSpec{
+				Name:       "pipeline1",
+				InputRefs:  []string{"application"},
+				OutputRefs: []string{"out1"},
+			},
+			setup: func(builder *ClusterLogForwarderBuilder) {
+				builder.Definition.Spec.Outputs = append(builder.Definition.Spec.Outputs, clov1.OutputSpec{Name: "out1"})
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			builder := buildTestBuilderWithDefinition(buildDummyClusterLogForwarder())
+
+			if testCase.setup != nil {
+				testCase.setup(builder)
+			}
+
+			builder.WithPipeline(testCase.pipeline)
+
+			if testCase.expectedError == "" {
+				assert.Empty(t, builder.errorMsg)
+			} else {
+				assert.Equal(t, testCase.expectedError, builder.errorMsg)
+			}
+		})
+	}
+}
+
+// TestDeleteWithPreserveOnDelete checks that WithPreserveOnDelete sets or clears the annotation that
+// Delete consults, and that Delete succeeds either way. The propagation policy sent with the delete
+// request is not observed by this test.
+func TestDeleteWithPreserveOnDelete(t *testing.T) {
+	testCases := []struct {
+		name     string
+		preserve bool
+	}{
+		{name: "cascading delete", preserve: false},
+		{name: "orphaning delete", preserve: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			clf := buildDummyClusterLogForwarder()
+			builder := buildTestBuilderWithDefinition(clf, clf)
+
+			builder.WithPreserveOnDelete(testCase.preserve)
+			assert.Equal(t, testCase.preserve, builder.preserveOnDelete())
+
+			err := builder.Delete()
+
+			assert.Nil(t, err)
+			assert.Nil(t, builder.Object)
+		})
+	}
+}